@@ -0,0 +1,119 @@
+package create
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/source-to-image/pkg/create/templates"
+)
+
+// Config holds the parameters 's2i create' uses to render a builder image
+// scaffold.
+type Config struct {
+	// ImageName is the name of the builder image being scaffolded.
+	ImageName string
+
+	// Language selects the Language (see the templates package) the
+	// scaffold is rendered from. Defaults to "generic".
+	Language string
+
+	// EnableIncrementalTest toggles the generated test/run's
+	// test_incremental_build() check.
+	EnableIncrementalTest bool
+
+	// DefaultPort is used as the test port when the built image does not
+	// declare an exposed port.
+	DefaultPort string
+
+	// ExposePort, when set, replaces DefaultPort: it becomes the port the
+	// Ginkgo harness probes and the generated test/run's fallback port. The
+	// generated test/run still probes the port the candidate image declares
+	// in its EXPOSE metadata when it declares one.
+	ExposePort string
+
+	// Ginkgo additionally emits the Go/Ginkgo e2e harness alongside the
+	// bash test/run script.
+	Ginkgo bool
+}
+
+// New returns a Config with the defaults 's2i create' uses when a flag is
+// left unset.
+func New(imageName string) *Config {
+	return &Config{
+		ImageName:   imageName,
+		Language:    "generic",
+		DefaultPort: "8080",
+	}
+}
+
+// AddFlags registers the '--language', '--incremental-test',
+// '--expose-port' and '--ginkgo' flags of 's2i create' on flags, storing
+// the results into cfg.
+func AddFlags(flags *pflag.FlagSet, cfg *Config) {
+	flags.StringVar(&cfg.Language, "language", cfg.Language,
+		fmt.Sprintf("Builder image language scaffold to generate, one of: %v", templates.LanguageNames()))
+	flags.BoolVar(&cfg.EnableIncrementalTest, "incremental-test", cfg.EnableIncrementalTest,
+		"Add a test/run check that a second build actually restores saved artifacts")
+	flags.StringVar(&cfg.ExposePort, "expose-port", cfg.ExposePort,
+		"Port used in place of the default port when the image declares no EXPOSE port (always used by the Ginkgo harness)")
+	flags.BoolVar(&cfg.Ginkgo, "ginkgo", cfg.Ginkgo,
+		"Also generate a Go/Ginkgo e2e test harness under test/e2e")
+}
+
+// templateData is the data the scaffold templates are rendered with.
+type templateData struct {
+	ImageName             string
+	EnableIncrementalTest bool
+	DefaultPort           string
+}
+
+// Create renders cfg's scaffold into destDir.
+func Create(cfg *Config, destDir string) error {
+	language, ok := templates.GetLanguage(cfg.Language)
+	if !ok {
+		return fmt.Errorf("unknown language %q, must be one of %v", cfg.Language, templates.LanguageNames())
+	}
+
+	defaultPort := cfg.DefaultPort
+	if cfg.ExposePort != "" {
+		defaultPort = cfg.ExposePort
+	}
+	data := templateData{
+		ImageName:             cfg.ImageName,
+		EnableIncrementalTest: cfg.EnableIncrementalTest,
+		DefaultPort:           defaultPort,
+	}
+
+	files := language.Files()
+	if cfg.Ginkgo {
+		files["test/e2e/suite_test.go"] = templates.TestRunGinkgoSuite
+		files["test/e2e/builder_test.go"] = templates.TestRunGinkgoSpec
+	}
+
+	for path, tpl := range files {
+		if err := renderFile(filepath.Join(destDir, path), tpl, data); err != nil {
+			return fmt.Errorf("rendering %s: %v", path, err)
+		}
+	}
+	return nil
+}
+
+func renderFile(path, tpl string, data templateData) error {
+	t, err := template.New(filepath.Base(path)).Parse(tpl)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0644)
+}
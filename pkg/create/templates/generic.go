@@ -0,0 +1,74 @@
+package templates
+
+// genericLanguage is the Language("generic") implementation and preserves
+// the original, language-agnostic `s2i create` output: a bash-only S2I
+// scripts skeleton whose assemble script only copies the source into
+// place and whose run script only echoes a placeholder.
+type genericLanguage struct{}
+
+func (genericLanguage) Files() map[string]string {
+	return map[string]string{
+		"Dockerfile":               GenericDockerfile,
+		"s2i/bin/assemble":         GenericAssemble,
+		"s2i/bin/run":              GenericRun,
+		"s2i/bin/usage":            GenericUsage,
+		"test/run":                 TestRunScript,
+		"test/test-app/index.html": Index,
+		"Makefile":                 Makefile,
+	}
+}
+
+// GenericDockerfile is a minimal Dockerfile for a generic S2I builder
+// image that only carries the S2I scripts.
+const GenericDockerfile = `FROM centos:7
+
+LABEL io.openshift.s2i.scripts-url="image:///usr/libexec/s2i"
+
+COPY ./s2i/bin/ /usr/libexec/s2i
+
+USER 1001
+
+CMD ["/usr/libexec/s2i/usage"]
+`
+
+// GenericAssemble is a sample implementation of an assemble script.
+const GenericAssemble = `#!/bin/bash -e
+#
+# S2I assemble script for the 'generic' S2I builder image.
+# The 'assemble' script builds the application artifacts from a source and
+# places them into appropriate directories inside the image.
+#
+# For more information refer to the documentation:
+# https://github.com/openshift/source-to-image/blob/master/docs/builder_image.md
+#
+
+echo "---> Assembling application source..."
+cp -Rf /tmp/src/. ./
+`
+
+// GenericRun is a sample implementation of a run script.
+const GenericRun = `#!/bin/bash -e
+#
+# S2I run script for the 'generic' S2I builder image.
+# The 'run' script executes the server that runs your application.
+#
+# For more information see the documentation:
+# https://github.com/openshift/source-to-image/blob/master/docs/builder_image.md
+#
+
+exec echo "Add application start-up code here"
+`
+
+// GenericUsage is a sample implementation of a usage script.
+const GenericUsage = `#!/bin/bash -e
+#
+# S2I usage script for the 'generic' S2I builder image.
+#
+
+cat <<EOF
+This is a generic S2I builder image. To use it, bind mount your application
+source into /tmp/src and run:
+
+    s2i build <source-location> <this-image> <output-image>
+EOF
+`
@@ -0,0 +1,123 @@
+package templates
+
+// TestRunGinkgoSuite bootstraps the Ginkgo suite for the generated Go e2e
+// test harness, registered alongside the bash test/run script.
+const TestRunGinkgoSuite = `package e2e
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestE2E(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "{{.ImageName}} S2I e2e suite")
+}
+`
+
+// TestRunGinkgoSpec exercises the candidate image the same way the bash
+// test/run script does - 's2i usage', 's2i build' (with and without
+// --incremental), and an HTTP probe of the resulting container - but as
+// structured Describe/It blocks so it can run under standard Go tooling
+// and emit JUnit XML via '--junit-report'.
+const TestRunGinkgoSpec = `package e2e
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/source-to-image/pkg/docker"
+)
+
+const (
+	imageName = "{{.ImageName}}-candidate"
+	testPort  = "{{.DefaultPort}}"
+)
+
+var _ = Describe("{{.ImageName}} builder image", func() {
+	var client docker.Docker
+
+	BeforeEach(func() {
+		engineClient, err := docker.NewEngineAPIClient(docker.GetDefaultDockerConfig())
+		Expect(err).NotTo(HaveOccurred())
+		client = docker.New(engineClient, nil)
+
+		_, err = client.CheckImage(imageName)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("prints usage information", func() {
+		Expect(runS2I("usage", imageName)).To(Succeed())
+	})
+
+	It("builds the test application without --incremental", func() {
+		outputImage := imageName + "-testapp"
+		Expect(runS2IBuild(imageName, outputImage, false)).To(Succeed())
+		Expect(serves200(outputImage)).To(BeTrue())
+	})
+
+	It("builds the test application twice with --incremental", func() {
+		outputImage := imageName + "-testapp-incremental"
+		Expect(runS2IBuild(imageName, outputImage, true)).To(Succeed())
+		Expect(runS2IBuild(imageName, outputImage, true)).To(Succeed())
+		Expect(serves200(outputImage)).To(BeTrue())
+	})
+})
+
+func runS2I(args ...string) error {
+	cmd := exec.Command("s2i", args...)
+	cmd.Stdout = GinkgoWriter
+	cmd.Stderr = GinkgoWriter
+	return cmd.Run()
+}
+
+func runS2IBuild(image, output string, incremental bool) error {
+	args := []string{"build", "--pull-policy=never", "../test-app", image, output}
+	if incremental {
+		args = append(args, "--incremental=true")
+	}
+	return runS2I(args...)
+}
+
+// serves200 starts image with its test port published to a random host
+// port, then polls that port until it answers (or the attempts run out)
+// and reports whether that answer was HTTP 200.
+func serves200(image string) (bool, error) {
+	out, err := exec.Command("docker", "run", "-d", "-P", image).Output()
+	if err != nil {
+		return false, err
+	}
+	cid := strings.TrimSpace(string(out))
+	defer exec.Command("docker", "rm", "-f", cid).Run()
+
+	hostPort, err := exec.Command("docker", "inspect",
+		"--format", fmt.Sprintf(`{{"{{"}}(index .NetworkSettings.Ports "%s/tcp" 0).HostPort{{"}}"}}`, testPort),
+		cid).Output()
+	if err != nil {
+		return false, err
+	}
+
+	addr := fmt.Sprintf("http://localhost:%s/", strings.TrimSpace(string(hostPort)))
+	var resp *http.Response
+	for attempt := 0; attempt < 10; attempt++ {
+		resp, err = http.Get(addr)
+		if err == nil {
+			break
+		}
+		time.Sleep(time.Second)
+	}
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode == http.StatusOK, nil
+}
+`
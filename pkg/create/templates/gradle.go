@@ -0,0 +1,121 @@
+package templates
+
+// gradleLanguage is the Language("gradle") implementation, producing a
+// builder image skeleton for Gradle-based Java applications.
+type gradleLanguage struct{}
+
+func (gradleLanguage) Files() map[string]string {
+	return map[string]string{
+		"Dockerfile":                           GradleDockerfile,
+		"s2i/bin/assemble":                     GradleAssemble,
+		"s2i/bin/run":                          GradleRun,
+		"s2i/bin/save-artifacts":               GradleSaveArtifacts,
+		"s2i/bin/usage":                        GradleUsage,
+		"test/run":                             TestRunScript,
+		"test/test-app/build.gradle":           GradleTestAppBuild,
+		"test/test-app/src/main/java/App.java": GradleTestAppSource,
+	}
+}
+
+// GradleDockerfile is a Dockerfile for a Gradle/Java S2I builder image.
+const GradleDockerfile = `FROM openjdk:11-jdk
+
+LABEL io.openshift.s2i.scripts-url="image:///usr/libexec/s2i"
+
+COPY ./s2i/bin/ /usr/libexec/s2i
+
+RUN mkdir -p /opt/app-root/src && chown -R 1001:0 /opt/app-root
+WORKDIR /opt/app-root/src
+ENV HOME=/opt/app-root/src
+
+EXPOSE 8080
+
+USER 1001
+
+CMD ["/usr/libexec/s2i/usage"]
+`
+
+// GradleAssemble builds the application with Gradle, restoring any
+// artifacts saved by a previous incremental build first.
+const GradleAssemble = `#!/bin/bash -e
+#
+# S2I assemble script for the 'gradle' S2I builder image.
+#
+
+echo "---> Installing application source..."
+cp -Rf /tmp/src/. ./
+
+if [ -d /tmp/artifacts ]; then
+  echo "---> Restoring previous build artifacts..."
+  cp -Rf /tmp/artifacts/. ./
+fi
+
+echo "---> Building application from source with Gradle..."
+gradle build --no-daemon
+`
+
+// GradleSaveArtifacts persists the Gradle cache and build output between
+// incremental builds.
+const GradleSaveArtifacts = `#!/bin/bash -e
+#
+# S2I save-artifacts script for the 'gradle' S2I builder image.
+#
+
+tar -cf - .gradle build 2>/dev/null
+`
+
+// GradleRun launches the jar produced by the Gradle build.
+const GradleRun = `#!/bin/bash -e
+#
+# S2I run script for the 'gradle' S2I builder image.
+#
+
+exec java -jar build/libs/*.jar
+`
+
+// GradleUsage prints usage information for the image.
+const GradleUsage = `#!/bin/bash -e
+cat <<EOF
+This is a Gradle/Java S2I builder image. To use it, install Gradle project
+sources into the image and run:
+
+    s2i build <source-location> <this-image> <output-image>
+EOF
+`
+
+// GradleTestAppBuild is a minimal build.gradle for the generated
+// test-app. It sets the jar's Main-Class explicitly, since the
+// 'application' plugin's own 'jar' task does not - without it,
+// 's2i/bin/run' (exec java -jar build/libs/*.jar) fails with "no main
+// manifest attribute".
+const GradleTestAppBuild = `plugins {
+    id 'application'
+}
+
+application {
+    mainClass = 'App'
+}
+
+jar {
+    manifest {
+        attributes 'Main-Class': 'App'
+    }
+}
+`
+
+// GradleTestAppSource is a minimal Java source file for the generated
+// test-app. It serves a static 200 response so the generated test/run
+// script can verify the HTTP connection.
+const GradleTestAppSource = `import com.sun.net.httpserver.HttpServer;
+import java.net.InetSocketAddress;
+
+public class App {
+    public static void main(String[] args) throws Exception {
+        HttpServer server = HttpServer.create(new InetSocketAddress(8080), 0);
+        server.createContext("/", exchange -> {
+            exchange.sendResponseHeaders(200, -1);
+        });
+        server.start();
+    }
+}
+`
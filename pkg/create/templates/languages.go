@@ -0,0 +1,52 @@
+package templates
+
+import "sort"
+
+// Language describes a builder-image scaffold that `s2i create` can
+// generate. Implementations contribute the full set of files for a new
+// builder image skeleton: Dockerfile, S2I scripts, tests and a sample
+// application.
+type Language interface {
+	// Files returns the scaffold files for this language, keyed by the
+	// path they should be written to relative to the builder image root
+	// (e.g. "Dockerfile", "s2i/bin/assemble", "test/run").
+	Files() map[string]string
+}
+
+// languages holds every Language registered with RegisterLanguage, keyed
+// by the name used with `s2i create --language`.
+var languages = map[string]Language{}
+
+// RegisterLanguage makes language available to `s2i create --language
+// name`. Third parties can call this from an init() to add support for
+// additional languages without modifying this package.
+func RegisterLanguage(name string, language Language) {
+	languages[name] = language
+}
+
+// GetLanguage looks up a Language previously added with RegisterLanguage.
+// The second return value is false if no language was registered under
+// that name.
+func GetLanguage(name string) (Language, bool) {
+	language, ok := languages[name]
+	return language, ok
+}
+
+// LanguageNames returns the names of all registered languages, sorted for
+// stable use in help text and flag validation.
+func LanguageNames() []string {
+	names := make([]string, 0, len(languages))
+	for name := range languages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	RegisterLanguage("generic", genericLanguage{})
+	RegisterLanguage("gradle", gradleLanguage{})
+	RegisterLanguage("python-venv", pythonVenvLanguage{})
+	RegisterLanguage("nodejs", nodejsLanguage{})
+	RegisterLanguage("ruby", rubyLanguage{})
+}
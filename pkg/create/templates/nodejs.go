@@ -0,0 +1,105 @@
+package templates
+
+// nodejsLanguage is the Language("nodejs") implementation, producing a
+// builder image skeleton for Node.js applications.
+type nodejsLanguage struct{}
+
+func (nodejsLanguage) Files() map[string]string {
+	return map[string]string{
+		"Dockerfile":                 NodejsDockerfile,
+		"s2i/bin/assemble":           NodejsAssemble,
+		"s2i/bin/run":                NodejsRun,
+		"s2i/bin/save-artifacts":     NodejsSaveArtifacts,
+		"s2i/bin/usage":              NodejsUsage,
+		"test/run":                   TestRunScript,
+		"test/test-app/package.json": NodejsTestAppPackageJSON,
+		"test/test-app/server.js":    NodejsTestAppSource,
+	}
+}
+
+// NodejsDockerfile is a Dockerfile for a Node.js S2I builder image.
+const NodejsDockerfile = `FROM node:20-slim
+
+LABEL io.openshift.s2i.scripts-url="image:///usr/libexec/s2i"
+
+COPY ./s2i/bin/ /usr/libexec/s2i
+
+RUN mkdir -p /opt/app-root/src && chown -R 1001:0 /opt/app-root
+WORKDIR /opt/app-root/src
+ENV HOME=/opt/app-root/src
+
+EXPOSE 8080
+
+USER 1001
+
+CMD ["/usr/libexec/s2i/usage"]
+`
+
+// NodejsAssemble installs the application's npm dependencies, restoring
+// node_modules from a previous incremental build when available.
+const NodejsAssemble = `#!/bin/bash -e
+#
+# S2I assemble script for the 'nodejs' S2I builder image.
+#
+
+echo "---> Installing application source..."
+cp -Rf /tmp/src/. ./
+
+if [ -d /tmp/artifacts/node_modules ]; then
+  echo "---> Restoring previous build artifacts..."
+  mv /tmp/artifacts/node_modules ./node_modules
+fi
+
+echo "---> Installing dependencies..."
+npm install
+`
+
+// NodejsSaveArtifacts persists node_modules between incremental builds.
+const NodejsSaveArtifacts = `#!/bin/bash -e
+#
+# S2I save-artifacts script for the 'nodejs' S2I builder image.
+#
+
+tar -cf - node_modules 2>/dev/null
+`
+
+// NodejsRun starts the application.
+const NodejsRun = `#!/bin/bash -e
+#
+# S2I run script for the 'nodejs' S2I builder image.
+#
+
+exec npm start
+`
+
+// NodejsUsage prints usage information for the image.
+const NodejsUsage = `#!/bin/bash -e
+cat <<EOF
+This is a Node.js S2I builder image. To use it, install Node.js project
+sources including a package.json into the image and run:
+
+    s2i build <source-location> <this-image> <output-image>
+EOF
+`
+
+// NodejsTestAppPackageJSON is a minimal package.json for the generated
+// test-app.
+const NodejsTestAppPackageJSON = `{
+  "name": "test-app",
+  "version": "1.0.0",
+  "scripts": {
+    "start": "node server.js"
+  }
+}
+`
+
+// NodejsTestAppSource is a minimal HTTP server for the generated
+// test-app, so the generated test/run script can verify the HTTP
+// connection.
+const NodejsTestAppSource = `const http = require('http');
+
+http.createServer((req, res) => {
+  res.writeHead(200);
+  res.end();
+}).listen(8080, '0.0.0.0');
+`
@@ -0,0 +1,114 @@
+package templates
+
+// pythonVenvLanguage is the Language("python-venv") implementation,
+// producing a builder image skeleton for Python applications that install
+// their dependencies into a virtualenv.
+type pythonVenvLanguage struct{}
+
+func (pythonVenvLanguage) Files() map[string]string {
+	return map[string]string{
+		"Dockerfile":                     PythonVenvDockerfile,
+		"s2i/bin/assemble":               PythonVenvAssemble,
+		"s2i/bin/run":                    PythonVenvRun,
+		"s2i/bin/save-artifacts":         PythonVenvSaveArtifacts,
+		"s2i/bin/usage":                  PythonVenvUsage,
+		"test/run":                       TestRunScript,
+		"test/test-app/requirements.txt": PythonVenvTestAppRequirements,
+		"test/test-app/app.py":           PythonVenvTestAppSource,
+	}
+}
+
+// PythonVenvDockerfile is a Dockerfile for a Python virtualenv S2I
+// builder image.
+const PythonVenvDockerfile = `FROM python:3.11-slim
+
+LABEL io.openshift.s2i.scripts-url="image:///usr/libexec/s2i"
+
+COPY ./s2i/bin/ /usr/libexec/s2i
+
+RUN mkdir -p /opt/app-root/src && chown -R 1001:0 /opt/app-root
+WORKDIR /opt/app-root/src
+ENV HOME=/opt/app-root/src
+
+EXPOSE 8080
+
+USER 1001
+
+CMD ["/usr/libexec/s2i/usage"]
+`
+
+// PythonVenvAssemble creates (or restores) a virtualenv and installs the
+// application's dependencies into it.
+const PythonVenvAssemble = `#!/bin/bash -e
+#
+# S2I assemble script for the 'python-venv' S2I builder image.
+#
+
+echo "---> Installing application source..."
+cp -Rf /tmp/src/. ./
+
+if [ -d /tmp/artifacts/venv ]; then
+  echo "---> Restoring previous build artifacts..."
+  mv /tmp/artifacts/venv ./venv
+else
+  echo "---> Creating virtualenv..."
+  python3 -m venv venv
+fi
+
+echo "---> Installing dependencies..."
+source venv/bin/activate
+if [ -f requirements.txt ]; then
+  pip install -r requirements.txt
+fi
+`
+
+// PythonVenvSaveArtifacts persists the virtualenv between incremental
+// builds.
+const PythonVenvSaveArtifacts = `#!/bin/bash -e
+#
+# S2I save-artifacts script for the 'python-venv' S2I builder image.
+#
+
+tar -cf - venv 2>/dev/null
+`
+
+// PythonVenvRun activates the virtualenv and starts the application.
+const PythonVenvRun = `#!/bin/bash -e
+#
+# S2I run script for the 'python-venv' S2I builder image.
+#
+
+source venv/bin/activate
+exec python3 app.py
+`
+
+// PythonVenvUsage prints usage information for the image.
+const PythonVenvUsage = `#!/bin/bash -e
+cat <<EOF
+This is a Python (venv) S2I builder image. To use it, install Python
+project sources including a requirements.txt into the image and run:
+
+    s2i build <source-location> <this-image> <output-image>
+EOF
+`
+
+// PythonVenvTestAppRequirements is an empty requirements.txt for the
+// generated test-app.
+const PythonVenvTestAppRequirements = `
+`
+
+// PythonVenvTestAppSource is a minimal Python source file for the
+// generated test-app. It serves a static 200 response so the generated
+// test/run script can verify the HTTP connection.
+const PythonVenvTestAppSource = `from http.server import BaseHTTPRequestHandler, HTTPServer
+
+
+class Handler(BaseHTTPRequestHandler):
+    def do_GET(self):
+        self.send_response(200)
+        self.end_headers()
+
+
+if __name__ == "__main__":
+    HTTPServer(("0.0.0.0", 8080), Handler).serve_forever()
+`
@@ -0,0 +1,102 @@
+package templates
+
+// rubyLanguage is the Language("ruby") implementation, producing a
+// builder image skeleton for Ruby applications that manage their
+// dependencies with Bundler.
+type rubyLanguage struct{}
+
+func (rubyLanguage) Files() map[string]string {
+	return map[string]string{
+		"Dockerfile":             RubyDockerfile,
+		"s2i/bin/assemble":       RubyAssemble,
+		"s2i/bin/run":            RubyRun,
+		"s2i/bin/save-artifacts": RubySaveArtifacts,
+		"s2i/bin/usage":          RubyUsage,
+		"test/run":               TestRunScript,
+		"test/test-app/Gemfile":  RubyTestAppGemfile,
+		"test/test-app/app.rb":   RubyTestAppSource,
+	}
+}
+
+// RubyDockerfile is a Dockerfile for a Ruby S2I builder image.
+const RubyDockerfile = `FROM ruby:3.2-slim
+
+LABEL io.openshift.s2i.scripts-url="image:///usr/libexec/s2i"
+
+COPY ./s2i/bin/ /usr/libexec/s2i
+
+RUN mkdir -p /opt/app-root/src && chown -R 1001:0 /opt/app-root
+WORKDIR /opt/app-root/src
+ENV HOME=/opt/app-root/src
+
+EXPOSE 8080
+
+USER 1001
+
+CMD ["/usr/libexec/s2i/usage"]
+`
+
+// RubyAssemble installs the application's gems, restoring vendor/bundle
+// from a previous incremental build when available.
+const RubyAssemble = `#!/bin/bash -e
+#
+# S2I assemble script for the 'ruby' S2I builder image.
+#
+
+echo "---> Installing application source..."
+cp -Rf /tmp/src/. ./
+
+if [ -d /tmp/artifacts/vendor/bundle ]; then
+  echo "---> Restoring previous build artifacts..."
+  mkdir -p vendor
+  mv /tmp/artifacts/vendor/bundle ./vendor/bundle
+fi
+
+echo "---> Installing dependencies..."
+bundle install --path vendor/bundle
+`
+
+// RubySaveArtifacts persists vendor/bundle between incremental builds.
+const RubySaveArtifacts = `#!/bin/bash -e
+#
+# S2I save-artifacts script for the 'ruby' S2I builder image.
+#
+
+tar -cf - vendor/bundle 2>/dev/null
+`
+
+// RubyRun starts the application.
+const RubyRun = `#!/bin/bash -e
+#
+# S2I run script for the 'ruby' S2I builder image.
+#
+
+exec bundle exec ruby app.rb
+`
+
+// RubyUsage prints usage information for the image.
+const RubyUsage = `#!/bin/bash -e
+cat <<EOF
+This is a Ruby S2I builder image. To use it, install Ruby project sources
+including a Gemfile into the image and run:
+
+    s2i build <source-location> <this-image> <output-image>
+EOF
+`
+
+// RubyTestAppGemfile is a minimal Gemfile for the generated test-app.
+// webrick is declared explicitly since it was removed from the Ruby
+// stdlib in 3.0 and is not bundled with the ruby:3.2-slim base image.
+const RubyTestAppGemfile = `source 'https://rubygems.org'
+
+gem 'webrick'
+`
+
+// RubyTestAppSource is a minimal HTTP server for the generated test-app,
+// so the generated test/run script can verify the HTTP connection.
+const RubyTestAppSource = `require 'webrick'
+
+server = WEBrick::HTTPServer.new(Port: 8080, BindAddress: '0.0.0.0')
+server.mount_proc('/') { |req, res| res.status = 200 }
+server.start
+`
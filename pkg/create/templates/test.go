@@ -1,6 +1,8 @@
 package templates
 
-// TestRunScript is a simple test script that verifies the S2I image.
+// TestRunScript is a simple test script that verifies the S2I image. When
+// the template is executed with EnableIncrementalTest set, it also asserts
+// that a second build actually restores artifacts saved by the first.
 const TestRunScript = `#!/bin/bash
 #
 # The 'run' performs a simple test that verifies the S2I image.
@@ -44,8 +46,19 @@ cid_file=$($MKTEMP_EXEC -u)
 # it from Docker hub
 s2i_args="--pull-policy=never --loglevel=2"
 
-# Port the image exposes service to be tested
-test_port=8080
+# Port the image exposes service to be tested.
+# Detected from the candidate image's EXPOSE metadata, falling back to
+# {{.DefaultPort}} if the image does not declare one.
+detect_test_port() {
+  local detected
+  detected=$($DOCKER_BINARY inspect --format="{{"{{"}}range \$k, \$_ := .Config.ExposedPorts{{"}}"}}{{"{{"}}\$k{{"}}"}}{{"{{"}}end{{"}}"}}" ${IMAGE_PREFIX}${IMAGE_NAME} 2>/dev/null | head -1 | cut -d/ -f1)
+  if [[ -z "${detected}" ]]; then
+    echo "{{.DefaultPort}}"
+  else
+    echo "${detected}"
+  fi
+}
+test_port=$(detect_test_port)
 
 image_exists() {
   $DOCKER_BINARY inspect $1 &>/dev/null
@@ -74,13 +87,45 @@ container_port() {
   fi
 }
 
+# artifact_volume is the podman volume used to carry 'save-artifacts'
+# output between incremental podman builds, so the podman path does not
+# need to round-trip through a generated Containerfile.
+artifact_volume="${IMAGE_NAME}-artifacts"
+
+run_podman_build() {
+  # Unlike the docker branch, which propagates 's2i build's exit code
+  # directly, every step below has to be checked explicitly - without
+  # 'set -e' in this script, a failure here would otherwise be masked by
+  # the exit status of the final 'rm -f'.
+  podman volume inspect "${artifact_volume}" &>/dev/null || podman volume create "${artifact_volume}" >/dev/null || return 1
+
+  local assemble_cid
+  assemble_cid=$(podman create \
+    --volume "${test_dir}"/test-app:/tmp/src:Z \
+    --volume "${artifact_volume}":/tmp/artifacts:Z \
+    "${IMAGE_PREFIX}${IMAGE_NAME}" /usr/libexec/s2i/assemble) || return 1
+  podman start -a "${assemble_cid}" || return 1
+  # The assemble container inherits the builder's CMD, which runs
+  # 'usage' rather than the assembled application - override it here so
+  # the committed image runs the app, matching what --as-dockerfile did.
+  podman commit --change 'CMD ["/usr/libexec/s2i/run"]' "${assemble_cid}" "${IMAGE_PREFIX}${IMAGE_NAME}-testapp" >/dev/null || return 1
+  podman rm "${assemble_cid}" >/dev/null || return 1
+
+  local save_cid
+  save_cid=$(podman create "${IMAGE_PREFIX}${IMAGE_NAME}-testapp" /usr/libexec/s2i/save-artifacts) || return 1
+  podman start -a "${save_cid}" >"${test_dir}"/artifacts.tar || return 1
+  podman rm "${save_cid}" >/dev/null || return 1
+
+  podman run --rm -i --volume "${artifact_volume}":/tmp/artifacts:Z "${IMAGE_PREFIX}${IMAGE_NAME}-testapp" \
+    tar -xf - -C /tmp/artifacts <"${test_dir}"/artifacts.tar || return 1
+  rm -f "${test_dir}"/artifacts.tar
+  return 0
+}
+
 run_s2i_build() {
   if [[ "${HAS_PODMAN}" == "true" ]]
   then
-    CONTAINER_FOLDER=$(mktemp -d)
-    s2i build --incremental=true ${s2i_args} "${test_dir}"/test-app ${IMAGE_PREFIX}${IMAGE_NAME} ${IMAGE_PREFIX}${IMAGE_NAME} --as-dockerfile "$CONTAINER_FOLDER"/Containerfile
-    podman build -t ${IMAGE_PREFIX}${IMAGE_NAME}-testapp -f $CONTAINER_FOLDER/Containerfile $CONTAINER_FOLDER
-    rm -fr "$CONTAINER_FOLDER"
+    run_podman_build
   else
     s2i build --incremental=true ${s2i_args} ${test_dir}/test-app ${IMAGE_NAME} ${IMAGE_NAME}-testapp
   fi
@@ -113,6 +158,9 @@ cleanup() {
   if image_exists ${IMAGE_PREFIX}${IMAGE_NAME}-testapp; then
     $DOCKER_BINARY rmi ${IMAGE_PREFIX}${IMAGE_NAME}-testapp
   fi
+  if [[ "${HAS_PODMAN}" == "true" ]]; then
+    podman volume rm "${artifact_volume}" &>/dev/null || true
+  fi
 }
 
 check_result() {
@@ -142,6 +190,32 @@ test_usage() {
   s2i usage ${s2i_args} ${IMAGE_PREFIX}${IMAGE_NAME} &>/dev/null
 }
 
+test_incremental_build() {
+  echo "Testing incremental build..."
+  local first_build_log=$($MKTEMP_EXEC)
+  local second_build_log=$($MKTEMP_EXEC)
+
+  run_s2i_build &>${first_build_log}
+  run_s2i_build &>${second_build_log}
+
+  if grep -q "Clean build will be performed because of error saving previous build artifacts" ${second_build_log}; then
+    echo "FAILED: incremental build did not save artifacts correctly"
+    cat ${second_build_log}
+    rm -f ${first_build_log} ${second_build_log}
+    return 1
+  fi
+
+  if ! grep -q "Restoring previous build artifacts" ${second_build_log}; then
+    echo "FAILED: incremental build did not restore saved artifacts"
+    cat ${second_build_log}
+    rm -f ${first_build_log} ${second_build_log}
+    return 1
+  fi
+
+  rm -f ${first_build_log} ${second_build_log}
+  return 0
+}
+
 test_connection() {
   echo "Testing HTTP connection (http://$(container_ip):$(container_port))"
   local max_attempts=10
@@ -174,6 +248,12 @@ check_result $?
 test_usage
 check_result $?
 
+{{if .EnableIncrementalTest}}
+# Verify that 'save-artifacts'/'restore-artifacts' actually restore artifacts
+# between builds, rather than silently falling back to a clean build
+test_incremental_build
+check_result $?
+{{end}}
 # Verify that the HTTP connection can be established to test application container
 run_test_application &
 